@@ -0,0 +1,92 @@
+package gojira
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAddAttachmentMultipartEncoding(t *testing.T) {
+	var gotToken, gotFilename, gotContentType string
+	var gotContent []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/issue/PROJ-1/attachments" {
+			t.Errorf("path = %s, want /issue/PROJ-1/attachments", r.URL.Path)
+		}
+
+		gotToken = r.Header.Get("X-Atlassian-Token")
+		gotContentType = r.Header.Get("Content-Type")
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile: %s", err)
+		}
+		defer file.Close()
+
+		gotFilename = header.Filename
+		gotContent, err = ioutil.ReadAll(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		json.NewEncoder(w).Encode([]*Attachment{{Id: "10000", Filename: gotFilename}})
+	}))
+	defer server.Close()
+
+	jira := &Jira{
+		BaseUrl: server.URL,
+		ApiPath: "",
+		Client:  server.Client(),
+		Auth:    &Auth{Login: "user", Password: "pass"},
+	}
+
+	result, err := jira.AddAttachment("PROJ-1", "log.txt", strings.NewReader("boom at line 42"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gotToken != "no-check" {
+		t.Errorf("X-Atlassian-Token = %q, want %q", gotToken, "no-check")
+	}
+	if !strings.HasPrefix(gotContentType, "multipart/form-data") {
+		t.Errorf("Content-Type = %q, want multipart/form-data prefix", gotContentType)
+	}
+	if gotFilename != "log.txt" {
+		t.Errorf("filename = %q, want %q", gotFilename, "log.txt")
+	}
+	if string(gotContent) != "boom at line 42" {
+		t.Errorf("content = %q, want %q", gotContent, "boom at line 42")
+	}
+
+	if len(result) != 1 || result[0].Id != "10000" {
+		t.Errorf("result = %+v, want a single attachment with id 10000", result)
+	}
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	jira := &Jira{
+		BaseUrl: server.URL,
+		ApiPath: "",
+		Client:  server.Client(),
+		Auth:    &Auth{Login: "user", Password: "pass"},
+	}
+
+	if err := jira.DeleteAttachment("10000"); err != nil {
+		t.Fatal(err)
+	}
+	if gotMethod != "DELETE" || gotPath != "/attachment/10000" {
+		t.Errorf("request = %s %s, want DELETE /attachment/10000", gotMethod, gotPath)
+	}
+}
@@ -0,0 +1,93 @@
+package gojira
+
+import "encoding/json"
+
+// issueFieldsKnownKeys lists the JSON keys IssueFields decodes into named
+// struct fields. Anything else found on the wire lands in Unknowns instead.
+var issueFieldsKnownKeys = map[string]bool{
+	"issuetype":   true,
+	"summary":     true,
+	"description": true,
+	"reporter":    true,
+	"assignee":    true,
+	"project":     true,
+	"created":     true,
+	"versions":    true,
+	"components":  true,
+	"labels":      true,
+	"priority":    true,
+	"status":      true,
+	"resolution":  true,
+	"issuelinks":  true,
+}
+
+// issueFieldsAlias has the same fields as IssueFields but none of its
+// methods, so it can be marshaled/unmarshaled without recursing back into
+// IssueFields.MarshalJSON/UnmarshalJSON.
+type issueFieldsAlias IssueFields
+
+// GetCustomField looks up a field by its Jira field id (e.g.
+// "customfield_10021"), returning ok=false if it wasn't present on the
+// issue.
+func (f *IssueFields) GetCustomField(id string) (interface{}, bool) {
+	v, ok := f.Unknowns[id]
+	return v, ok
+}
+
+// SetCustomField sets a field by its Jira field id. It's included verbatim
+// the next time the IssueFields is marshaled, so it works for any custom
+// field without this package knowing its shape ahead of time.
+func (f *IssueFields) SetCustomField(id string, v interface{}) {
+	if f.Unknowns == nil {
+		f.Unknowns = map[string]interface{}{}
+	}
+	f.Unknowns[id] = v
+}
+
+func (f *IssueFields) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal((*issueFieldsAlias)(f))
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Unknowns) == 0 {
+		return encoded, nil
+	}
+
+	var merged map[string]interface{}
+	if err := json.Unmarshal(encoded, &merged); err != nil {
+		return nil, err
+	}
+	for k, v := range f.Unknowns {
+		merged[k] = v
+	}
+	return json.Marshal(merged)
+}
+
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	var a issueFieldsAlias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+	*f = IssueFields(a)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	f.Unknowns = nil
+	for k, v := range raw {
+		if issueFieldsKnownKeys[k] {
+			continue
+		}
+		var val interface{}
+		if err := json.Unmarshal(v, &val); err != nil {
+			return err
+		}
+		if f.Unknowns == nil {
+			f.Unknowns = map[string]interface{}{}
+		}
+		f.Unknowns[k] = val
+	}
+	return nil
+}
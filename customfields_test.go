@@ -0,0 +1,84 @@
+package gojira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestIssueFieldsRoundTrip guards the invariant issueFieldsKnownKeys exists
+// to preserve: unmarshal -> remarshal of a payload mixing known and custom
+// fields must reproduce the original JSON, with known fields decoded onto
+// named struct fields and everything else preserved verbatim in Unknowns.
+func TestIssueFieldsRoundTrip(t *testing.T) {
+	original := `{
+		"summary": "something broke",
+		"description": "it broke badly",
+		"labels": ["bug", "urgent"],
+		"customfield_10021": 42.5,
+		"customfield_10022": "abcdef",
+		"customfield_10099": {"value": "nested"}
+	}`
+
+	var fields IssueFields
+	if err := json.Unmarshal([]byte(original), &fields); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+
+	if fields.Summary != "something broke" {
+		t.Errorf("Summary = %q, want %q", fields.Summary, "something broke")
+	}
+	if fields.Description != "it broke badly" {
+		t.Errorf("Description = %q, want %q", fields.Description, "it broke badly")
+	}
+	if !reflect.DeepEqual(fields.Labels, []string{"bug", "urgent"}) {
+		t.Errorf("Labels = %v, want [bug urgent]", fields.Labels)
+	}
+
+	if v, ok := fields.GetCustomField("customfield_10021"); !ok || v.(float64) != 42.5 {
+		t.Errorf("customfield_10021 = %v, ok=%v, want 42.5", v, ok)
+	}
+	if v, ok := fields.GetCustomField("customfield_10022"); !ok || v.(string) != "abcdef" {
+		t.Errorf("customfield_10022 = %v, ok=%v, want abcdef", v, ok)
+	}
+	if _, ok := fields.Unknowns["summary"]; ok {
+		t.Errorf("known field %q leaked into Unknowns", "summary")
+	}
+
+	encoded, err := json.Marshal(&fields)
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+
+	var roundTripped map[string]interface{}
+	if err := json.Unmarshal(encoded, &roundTripped); err != nil {
+		t.Fatalf("unmarshal round-tripped: %s", err)
+	}
+
+	var wantMap map[string]interface{}
+	if err := json.Unmarshal([]byte(original), &wantMap); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(roundTripped, wantMap) {
+		t.Errorf("round trip mismatch:\ngot:  %#v\nwant: %#v", roundTripped, wantMap)
+	}
+}
+
+func TestIssueFieldsSetCustomField(t *testing.T) {
+	var fields IssueFields
+	fields.SetCustomField("customfield_10021", 7)
+
+	encoded, err := json.Marshal(&fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded["customfield_10021"] != float64(7) {
+		t.Errorf("customfield_10021 = %v, want 7", decoded["customfield_10021"])
+	}
+}
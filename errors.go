@@ -0,0 +1,39 @@
+package gojira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// JiraError wraps a non-2xx HTTP response from the Jira REST API. It
+// decodes Jira's standard error envelope (errorMessages/errors) when the
+// response body is shaped that way, but is still returned even if the body
+// is empty or unparseable so callers always get the status code.
+type JiraError struct {
+	StatusCode int
+	Body       []byte
+
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
+func (e *JiraError) Error() string {
+	if len(e.ErrorMessages) > 0 {
+		return fmt.Sprintf("jira: %d: %s", e.StatusCode, e.ErrorMessages[0])
+	}
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("jira: %d: %v", e.StatusCode, e.Errors)
+	}
+	return fmt.Sprintf("jira: unexpected status %d", e.StatusCode)
+}
+
+// newJiraError builds a JiraError from a response and its already-read
+// body, decoding Jira's error JSON envelope on a best-effort basis.
+func newJiraError(resp *http.Response, body []byte) *JiraError {
+	e := &JiraError{StatusCode: resp.StatusCode, Body: body}
+	// Jira doesn't always return the error envelope (e.g. a 404 from a
+	// proxy in front of Jira might return HTML); ignore decode failures.
+	json.Unmarshal(body, e)
+	return e
+}
@@ -0,0 +1,57 @@
+package gojira
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewJiraError(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		body    string
+		wantMsg string
+	}{
+		{
+			name:    "errorMessages envelope",
+			status:  404,
+			body:    `{"errorMessages":["Issue does not exist"],"errors":{}}`,
+			wantMsg: "jira: 404: Issue does not exist",
+		},
+		{
+			name:    "errors map envelope",
+			status:  400,
+			body:    `{"errorMessages":[],"errors":{"summary":"Summary is required."}}`,
+			wantMsg: "jira: 400: map[summary:Summary is required.]",
+		},
+		{
+			name:    "non-JSON body",
+			status:  502,
+			body:    "<html>Bad Gateway</html>",
+			wantMsg: "jira: unexpected status 502",
+		},
+		{
+			name:    "empty body",
+			status:  401,
+			body:    "",
+			wantMsg: "jira: unexpected status 401",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: c.status}
+			err := newJiraError(resp, []byte(c.body))
+
+			if err.StatusCode != c.status {
+				t.Errorf("StatusCode = %d, want %d", err.StatusCode, c.status)
+			}
+			if string(err.Body) != c.body {
+				t.Errorf("Body = %q, want %q", err.Body, c.body)
+			}
+			if got := err.Error(); got != c.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, c.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package gojira
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type Component struct {
+	Id          string `json:"id,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"`
+}
+
+type Priority struct {
+	Self    string `json:"self,omitempty"`
+	Id      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	IconUrl string `json:"iconUrl,omitempty"`
+}
+
+type Status struct {
+	Self        string `json:"self,omitempty"`
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconUrl     string `json:"iconUrl,omitempty"`
+}
+
+type Resolution struct {
+	Self        string `json:"self,omitempty"`
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// IssueLinkType describes a relationship Jira can draw between two issues,
+// e.g. "Duplicate", with its inward/outward phrasing ("is duplicated by" /
+// "duplicates").
+type IssueLinkType struct {
+	Id      string `json:"id,omitempty"`
+	Self    string `json:"self,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+}
+
+// IssueLink is a single link from one issue to another, as it appears in
+// IssueFields.IssueLinks.
+type IssueLink struct {
+	Id           string         `json:"id,omitempty"`
+	Self         string         `json:"self,omitempty"`
+	Type         *IssueLinkType `json:"type,omitempty"`
+	InwardIssue  *IssueRef      `json:"inwardIssue,omitempty"`
+	OutwardIssue *IssueRef      `json:"outwardIssue,omitempty"`
+}
+
+// Transition is one step of an issue's workflow, as returned by
+// GetTransitions, e.g. {Id: "31", Name: "Resolve Issue"}.
+type Transition struct {
+	Id   string  `json:"id,omitempty"`
+	Name string  `json:"name,omitempty"`
+	To   *Status `json:"to,omitempty"`
+}
+
+type transitionsResult struct {
+	Transitions []*Transition `json:"transitions"`
+}
+
+// LinkIssues creates a link of the given type (e.g. "Duplicate", "Blocks")
+// between inwardKey and outwardKey.
+func (j *Jira) LinkIssues(inwardKey, outwardKey, linkType string) error {
+	encoded, err := json.MarshalIndent(struct {
+		Type         *IssueLinkType `json:"type"`
+		InwardIssue  *IssueRef      `json:"inwardIssue"`
+		OutwardIssue *IssueRef      `json:"outwardIssue"`
+	}{
+		Type:         &IssueLinkType{Name: linkType},
+		InwardIssue:  &IssueRef{Key: inwardKey},
+		OutwardIssue: &IssueRef{Key: outwardKey},
+	}, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/issueLink"
+	_, _, err = j.buildAndExecRequest("POST", url, body)
+	return err
+}
+
+// RemoveIssueLink deletes the issue link with the given id.
+func (j *Jira) RemoveIssueLink(id string) error {
+	url := j.BaseUrl + j.ApiPath + "/issueLink/" + id
+	_, _, err := j.buildAndExecRequest("DELETE", url, nil)
+	return err
+}
+
+// GetIssueLinkTypes lists the link types configured on this Jira instance.
+func (j *Jira) GetIssueLinkTypes() ([]*IssueLinkType, error) {
+	url := j.BaseUrl + j.ApiPath + "/issueLinkType"
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return result.IssueLinkTypes, nil
+}
+
+// GetComponents lists the components configured for the given project.
+func (j *Jira) GetComponents(projectKey string) ([]*Component, error) {
+	url := j.BaseUrl + j.ApiPath + "/project/" + projectKey + "/components"
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Component
+	err = json.Unmarshal(contents, &result)
+	return result, err
+}
+
+// CreateComponent creates a new component. c.Project must be set.
+func (j *Jira) CreateComponent(c *Component) (*Component, error) {
+	encoded, err := json.MarshalIndent(c, "", "   ")
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/component"
+	contents, _, err := j.buildAndExecRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Component
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AssignComponent tags issueKey with component c, leaving any existing
+// components in place.
+func (j *Jira) AssignComponent(issueKey string, c *Component) error {
+	encoded, err := json.MarshalIndent(struct {
+		Update struct {
+			Components []map[string]*Component `json:"components"`
+		} `json:"update"`
+	}{
+		Update: struct {
+			Components []map[string]*Component `json:"components"`
+		}{
+			Components: []map[string]*Component{{"add": c}},
+		},
+	}, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey
+	_, _, err = j.buildAndExecRequest("PUT", url, body)
+	return err
+}
+
+// GetTransitions lists the workflow transitions currently available for
+// issueKey.
+func (j *Jira) GetTransitions(issueKey string) ([]*Transition, error) {
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/transitions"
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result transitionsResult
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return result.Transitions, nil
+}
+
+// DoTransition fires the transition with the given id against issueKey,
+// optionally setting fields as part of the same request (e.g.
+// {"resolution": {"name": "Fixed"}}).
+func (j *Jira) DoTransition(issueKey, transitionId string, fields map[string]interface{}) error {
+	payload := struct {
+		Transition *Transition            `json:"transition"`
+		Fields     map[string]interface{} `json:"fields,omitempty"`
+	}{
+		Transition: &Transition{Id: transitionId},
+		Fields:     fields,
+	}
+
+	encoded, err := json.MarshalIndent(payload, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/transitions"
+	_, _, err = j.buildAndExecRequest("POST", url, body)
+	return err
+}
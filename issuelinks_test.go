@@ -0,0 +1,121 @@
+package gojira
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestJira points a Jira at a test server, capturing every request body
+// it receives as a decoded JSON map in captured.
+func newTestJira(t *testing.T, captured *map[string]interface{}, method, path string) (*Jira, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != path {
+			t.Errorf("request = %s %s, want %s %s", r.Method, r.URL.Path, method, path)
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, captured); err != nil {
+				t.Fatalf("request body is not valid JSON: %s: %s", err, body)
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	jira := &Jira{
+		BaseUrl: server.URL,
+		ApiPath: "",
+		Client:  server.Client(),
+		Auth:    &Auth{Login: "user", Password: "pass"},
+	}
+	return jira, server
+}
+
+func TestLinkIssuesRequestBody(t *testing.T) {
+	var captured map[string]interface{}
+	jira, server := newTestJira(t, &captured, "POST", "/issueLink")
+	defer server.Close()
+
+	if err := jira.LinkIssues("PROJ-1", "PROJ-2", "Duplicate"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"type":         map[string]interface{}{"name": "Duplicate"},
+		"inwardIssue":  map[string]interface{}{"key": "PROJ-1"},
+		"outwardIssue": map[string]interface{}{"key": "PROJ-2"},
+	}
+	assertJSONEqual(t, captured, want)
+}
+
+func TestAssignComponentRequestBody(t *testing.T) {
+	var captured map[string]interface{}
+	jira, server := newTestJira(t, &captured, "PUT", "/issue/PROJ-1")
+	defer server.Close()
+
+	if err := jira.AssignComponent("PROJ-1", &Component{Id: "10000", Name: "Backend"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"update": map[string]interface{}{
+			"components": []interface{}{
+				map[string]interface{}{
+					"add": map[string]interface{}{"id": "10000", "name": "Backend"},
+				},
+			},
+		},
+	}
+	assertJSONEqual(t, captured, want)
+}
+
+func TestDoTransitionRequestBody(t *testing.T) {
+	var captured map[string]interface{}
+	jira, server := newTestJira(t, &captured, "POST", "/issue/PROJ-1/transitions")
+	defer server.Close()
+
+	fields := map[string]interface{}{"resolution": map[string]interface{}{"name": "Fixed"}}
+	if err := jira.DoTransition("PROJ-1", "31", fields); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"transition": map[string]interface{}{"id": "31"},
+		"fields": map[string]interface{}{
+			"resolution": map[string]interface{}{"name": "Fixed"},
+		},
+	}
+	assertJSONEqual(t, captured, want)
+}
+
+func assertJSONEqual(t *testing.T, got, want map[string]interface{}) {
+	t.Helper()
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotNorm, wantNorm interface{}
+	json.Unmarshal(gotJSON, &gotNorm)
+	json.Unmarshal(wantJSON, &wantNorm)
+
+	gotCanon, _ := json.Marshal(gotNorm)
+	wantCanon, _ := json.Marshal(wantNorm)
+	if string(gotCanon) != string(wantCanon) {
+		t.Errorf("request body = %s, want %s", gotCanon, wantCanon)
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"os"
-	"errors"
 	"bytes"
 	"strings"
 	"io/ioutil"
@@ -26,11 +24,31 @@ type Jira struct {
 	ActivityPath string
 	Client       *http.Client
 	Auth         *Auth
+
+	// Debug, if set, is called with the raw request/response body of
+	// every request. It replaces the old behavior of unconditionally
+	// writing last_body.txt/last_response.txt to the working directory.
+	Debug DebugHook
 }
 
+// DebugHook is called once per request when Jira.Debug is set, with the
+// HTTP method/URL and the raw request and response bodies (requestBody is
+// nil for bodyless requests such as GET).
+type DebugHook func(method, url string, requestBody, responseBody []byte)
+
 type Auth struct {
 	Login    string
 	Password string
+
+	// PersonalAccessToken, if set, is sent as a Bearer token instead of
+	// using Login/Password. Preferred over Basic auth on instances where
+	// PATs are available (Jira Server/Data Center 8.14+).
+	PersonalAccessToken string
+
+	// OAuth, if set, signs every request with OAuth 1.0a (RSA-SHA1)
+	// instead of using Login/Password or PersonalAccessToken. Use
+	// NewOAuthHandshake to obtain an AccessToken/AccessSecret pair.
+	OAuth *OAuthCredentials
 }
 
 type Version struct {
@@ -96,10 +114,29 @@ type IssueFields struct {
 	Project     *JiraProject `json:"project,omitempty"`
 	Created     string       `json:"created,omitempty"`
 	Versions    []*Version	 `json:"versions,omitempty"`
-	// ug. how do we make this generic?
-	CrashReportId float32 `json:"customfield_10021,omitempty"`
-	BacktraceHash string  `json:"customfield_10022,omitempty"`
-	CrashCount	  float32 `json:"customfield_10023,omitempty"`
+	Components  []*Component  `json:"components,omitempty"`
+	Labels      []string      `json:"labels,omitempty"`
+	Priority    *Priority     `json:"priority,omitempty"`
+	Status      *Status       `json:"status,omitempty"`
+	Resolution  *Resolution   `json:"resolution,omitempty"`
+	IssueLinks  []*IssueLink  `json:"issuelinks,omitempty"`
+
+	// Unknowns holds any JSON field Jira sent back that isn't modeled
+	// above, keyed by field id (e.g. "customfield_10021"). It round-trips
+	// through MarshalJSON/UnmarshalJSON so per-instance custom fields work
+	// without recompiling against a hardcoded field id. Use GetCustomField
+	// / SetCustomField rather than touching this directly.
+	Unknowns map[string]interface{} `json:"-"`
+}
+
+type User struct {
+	Self         string            `json:"self,omitempty"`
+	Key          string            `json:"key,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	EmailAddress string            `json:"emailAddress,omitempty"`
+	DisplayName  string            `json:"displayName,omitempty"`
+	Active       bool              `json:"active,omitempty"`
+	AvatarUrls   map[string]string `json:"avatarUrls,omitempty"`
 }
 
 type IssueType struct {
@@ -181,53 +218,96 @@ const (
 	DateLayout = "2006-01-02T15:04:05.000-0700"
 )
 
-func (j *Jira) buildAndExecRequest(method string, url string, body io.Reader) []byte {
-	if body != nil {
-		fo, err := os.Create("last_body.txt")
+// setAuth signs req according to whichever auth strategy j.Auth is
+// configured for: OAuth 1.0a takes precedence, then a Personal Access
+// Token, falling back to HTTP Basic auth.
+func (j *Jira) setAuth(req *http.Request) error {
+	switch {
+	case j.Auth.OAuth != nil:
+		header, err := j.Auth.OAuth.authHeader(req.Method, req.URL.String())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", header)
+	case j.Auth.PersonalAccessToken != "":
+		req.Header.Set("Authorization", "Bearer "+j.Auth.PersonalAccessToken)
+	default:
+		req.SetBasicAuth(j.Auth.Login, j.Auth.Password)
+	}
+	return nil
+}
+
+// requestOptions shapes a non-default request: a non-JSON content type
+// (e.g. multipart/form-data for attachments), extra headers a particular
+// endpoint requires (e.g. X-Atlassian-Token), and the body to send.
+type requestOptions struct {
+	ContentType  string
+	ExtraHeaders map[string]string
+	Body         io.Reader
+}
+
+func (j *Jira) buildAndExecRequest(method string, url string, body io.Reader) ([]byte, *http.Response, error) {
+	return j.execRequest(method, url, &requestOptions{Body: body})
+}
+
+func (j *Jira) execRequest(method string, url string, opts *requestOptions) ([]byte, *http.Response, error) {
+	body := opts.Body
+
+	var requestBody []byte
+	if body != nil && j.Debug != nil {
+		b, err := ioutil.ReadAll(body)
 		if err != nil {
-			panic("could not create last_response.txt")
+			return nil, nil, err
 		}
-		defer fo.Close()
-		body = io.TeeReader(body, fo)
+		requestBody = b
+		body = bytes.NewReader(b)
 	}
 
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		panic("Error while building jira request")
+		return nil, nil, fmt.Errorf("building jira request: %s", err)
 	}
 	if body != nil {
-		req.Header.Add("Content-Type", "application/json;charset=UTF-8")
+		contentType := opts.ContentType
+		if contentType == "" {
+			contentType = "application/json;charset=UTF-8"
+		}
+		req.Header.Add("Content-Type", contentType)
+	}
+	for header, value := range opts.ExtraHeaders {
+		req.Header.Set(header, value)
 	}
 
-	req.SetBasicAuth(j.Auth.Login, j.Auth.Password)
+	if err := j.setAuth(req); err != nil {
+		return nil, nil, err
+	}
 
 	resp, err := j.Client.Do(req)
-   if err != nil {
-      fmt.Printf("Request failed: %s", err.Error())
-      return nil
-   }
+	if err != nil {
+		return nil, nil, err
+	}
 	defer resp.Body.Close()
 	if resp.Header.Get("Content-Encoding") == "gzip" {
 		resp.Body, err = gzip.NewReader(resp.Body)
 		if err != nil {
-			panic(err)
+			return nil, resp, err
 		}
 		defer resp.Body.Close()
 	}
 	contents, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		fmt.Printf("%s", err)
+		return nil, resp, err
 	}
 
-	fo, err := os.Create("last_response.txt")
-	if err != nil {
-		panic("could not create last_response.txt")
+	if j.Debug != nil {
+		j.Debug(method, url, requestBody, contents)
+	}
+
+	if resp.StatusCode >= 300 {
+		return contents, resp, newJiraError(resp, contents)
 	}
-	defer fo.Close()
-	_, err = fo.Write(contents)
-	// fmt.Printf("response\n%s\n", contents)
 
-	return contents
+	return contents, resp, nil
 }
 
 func (j *Jira) UserActivity(user string) (ActivityFeed, error) {
@@ -238,27 +318,28 @@ func (j *Jira) UserActivity(user string) (ActivityFeed, error) {
 
 func (j *Jira) Activity(url string) (ActivityFeed, error) {
 
-	contents := j.buildAndExecRequest("GET", url, nil)
-
-	var activity ActivityFeed
-	err := xml.Unmarshal(contents, &activity)
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
 	if err != nil {
-		fmt.Println("%s", err)
+		return ActivityFeed{}, err
 	}
 
+	var activity ActivityFeed
+	err = xml.Unmarshal(contents, &activity)
 	return activity, err
 }
 
 // search issues assigned to given user
-func (j *Jira) IssuesAssignedTo(user string, maxResults int, startAt int) SearchResult {
+func (j *Jira) IssuesAssignedTo(user string, maxResults int, startAt int) (SearchResult, error) {
 
 	url := j.BaseUrl + j.ApiPath + "/search?jql=assignee=\"" + url.QueryEscape(user) + "\"&startAt=" + strconv.Itoa(startAt) + "&maxResults=" + strconv.Itoa(maxResults)
-	contents := j.buildAndExecRequest("GET", url, nil)
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
+	if err != nil {
+		return SearchResult{}, err
+	}
 
 	var issues SearchResult
-	err := json.Unmarshal(contents, &issues)
-	if err != nil {
-		fmt.Println("%s", err)
+	if err := json.Unmarshal(contents, &issues); err != nil {
+		return issues, err
 	}
 
 	pagination := Pagination{
@@ -270,25 +351,24 @@ func (j *Jira) IssuesAssignedTo(user string, maxResults int, startAt int) Search
 
 	issues.Pagination = &pagination
 
-	return issues
+	return issues, nil
 }
 
 // search an issue by its id
-func (j *Jira) Issue(id string) Issue {
+func (j *Jira) Issue(id string) (Issue, error) {
 
 	url := j.BaseUrl + j.ApiPath + "/issue/" + id
-	contents := j.buildAndExecRequest("GET", url, nil)
-
-	var issue Issue
-	err := json.Unmarshal(contents, &issue)
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
 	if err != nil {
-		fmt.Println("%s", err)
+		return Issue{}, err
 	}
 
-	return issue
+	var issue Issue
+	err = json.Unmarshal(contents, &issue)
+	return issue, err
 }
 
-func (j *Jira) SaveIssue(issue *Issue) (error) {
+func (j *Jira) SaveIssue(issue *Issue) error {
 	// zero out these values so they don't get pushed
 	issue.Fields.Reporter = nil
 	issue.Fields.Assignee = nil
@@ -302,12 +382,8 @@ func (j *Jira) SaveIssue(issue *Issue) (error) {
 
 	body := bytes.NewBuffer(encoded)
 	url := j.BaseUrl + j.ApiPath + "/issue/" + issue.Key
-	contents := j.buildAndExecRequest("PUT", url, body)
-
-	if len(contents) > 0 {
-	   return errors.New(fmt.Sprintf("error: %s", contents))
-	}
-   return nil
+	_, _, err = j.buildAndExecRequest("PUT", url, body)
+	return err
 }
 
 func NewIssue(project string, issue_type string) (*Issue) {
@@ -329,31 +405,29 @@ func (j *Jira) CreateIssue(issue *Issue) (*IssueRef, error) {
 	}
 	body := bytes.NewBuffer(encoded)
 	url := j.BaseUrl + j.ApiPath + "/issue/"
-	contents := j.buildAndExecRequest("POST", url, body)
+	contents, _, err := j.buildAndExecRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
 
 	var result IssueRef
-   fmt.Printf("unmarshalling...%s\n", contents)
-	err = json.Unmarshal(contents, &result)
-	if err != nil {
+	if err := json.Unmarshal(contents, &result); err != nil {
 		return nil, err
 	}
-	fmt.Printf("done!! %s...\n", result)
 	return &result, nil
 }
 
 func (j *Jira) Search(jql string, maxResults int) (SearchResult, error) {
 	url := j.BaseUrl + j.ApiPath + "/search?jql=" + url.QueryEscape(jql) + "&maxResults=" + strconv.Itoa(maxResults)
 
-	contents := j.buildAndExecRequest("GET", url, nil)
-
-	var issues SearchResult
-	//fmt.Printf("unmarshalling...%s\n", contents)
-	err := json.Unmarshal(contents, &issues)
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
 	if err != nil {
-		return issues, err
+		return SearchResult{}, err
 	}
-	// fmt.Printf("done!! %s...\n", issues)
-	return issues, nil
+
+	var issues SearchResult
+	err = json.Unmarshal(contents, &issues)
+	return issues, err
 }
 
 func (j *Jira) AddComment(issue_key string, comment *Comment) (*Comment, error) {
@@ -366,35 +440,33 @@ func (j *Jira) AddComment(issue_key string, comment *Comment) (*Comment, error)
 	}
 	body := bytes.NewBuffer(encoded)
 	url := j.BaseUrl + j.ApiPath + "/issue/" + issue_key + "/comment"
-	contents := j.buildAndExecRequest("POST", url, body)
-
-	fmt.Printf("unmarshalling...%s\n", contents)
-	err = json.Unmarshal(contents, &result)
+	contents, _, err := j.buildAndExecRequest("POST", url, body)
 	if err != nil {
 		log.Printf("error: %s\n", err)
 		return nil, err
 	}
-	// fmt.Printf("done!! %s...\n", result)
+
+	if err := json.Unmarshal(contents, &result); err != nil {
+		log.Printf("error: %s\n", err)
+		return nil, err
+	}
 	return &result, nil
 }
 
 func (j *Jira) GetAllVersions(productKey string) ([]*Version, error) {
 	url := j.BaseUrl + j.ApiPath + "/project/" + productKey + "/versions"
 
-	contents := j.buildAndExecRequest("GET", url, nil)
-
-	var result []*Version;
-	// fmt.Printf("unmarshalling...\n")
-	err := json.Unmarshal(contents, &result)
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
 	if err != nil {
-		return result, err
+		return nil, err
 	}
-	// fmt.Printf("done!! %s...\n", issues)
-	return result, nil
-}
 
+	var result []*Version
+	err = json.Unmarshal(contents, &result)
+	return result, err
+}
 
-func (j *Jira) AddVersionToIssue(issue *IssueRef, version *Version) (error) {
+func (j *Jira) AddVersionToIssue(issue *IssueRef, version *Version) error {
 	encoded := fmt.Sprintf(`
 		{
 			"update" : {
@@ -405,12 +477,8 @@ func (j *Jira) AddVersionToIssue(issue *IssueRef, version *Version) (error) {
 
 	body := strings.NewReader(encoded)
 	url := j.BaseUrl + j.ApiPath + "/issue/" + issue.Key
-	contents := j.buildAndExecRequest("PUT", url, body)
-
-	if len(contents) == 0 {
-		return nil
-	}
-	return errors.New(fmt.Sprintf("error: %s", contents))
+	_, _, err := j.buildAndExecRequest("PUT", url, body)
+	return err
 }
 
 func (j *Jira) CreateVersion(version *Version) (*Version, error) {
@@ -422,14 +490,15 @@ func (j *Jira) CreateVersion(version *Version) (*Version, error) {
 	}
 	body := bytes.NewBuffer(encoded)
 	url := j.BaseUrl + j.ApiPath + "/version/"
-	contents := j.buildAndExecRequest("POST", url, body)
+	contents, _, err := j.buildAndExecRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
 
-	// fmt.Printf("unmarshalling...\n")
 	err = json.Unmarshal(contents, &result)
 	if err != nil {
 		return nil, err
 	}
-	// fmt.Printf("done!! %s...\n", result)
 	return &result, nil
 }
 
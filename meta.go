@@ -0,0 +1,81 @@
+package gojira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// FieldSchema describes the type of a single field as reported by
+// createmeta/editmeta, e.g. {"type":"array","items":"string"} for Labels.
+type FieldSchema struct {
+	Type     string `json:"type"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomId int    `json:"customId,omitempty"`
+}
+
+// FieldMeta describes one field of an issue's create or edit form: whether
+// it's required and what shape of value it expects. Keyed by field id
+// (e.g. "customfield_10021") in the maps returned by GetCreateMeta /
+// GetEditMeta.
+type FieldMeta struct {
+	Required bool         `json:"required"`
+	Name     string       `json:"name"`
+	Key      string       `json:"key,omitempty"`
+	Schema   *FieldSchema `json:"schema,omitempty"`
+}
+
+// GetCreateMeta fetches the field schema Jira expects when creating an
+// issue of issueType in project projectKey, so callers can build a valid
+// payload for any Jira configuration (including per-instance custom
+// fields) without hardcoding field ids.
+func (j *Jira) GetCreateMeta(projectKey, issueType string) (map[string]*FieldMeta, error) {
+	requestUrl := j.BaseUrl + j.ApiPath + "/issue/createmeta?projectKeys=" +
+		url.QueryEscape(projectKey) + "&issuetypeNames=" + url.QueryEscape(issueType) +
+		"&expand=projects.issuetypes.fields"
+
+	contents, _, err := j.buildAndExecRequest("GET", requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Projects []struct {
+			Key        string `json:"key"`
+			IssueTypes []struct {
+				Name   string                `json:"name"`
+				Fields map[string]*FieldMeta `json:"fields"`
+			} `json:"issuetypes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+
+	for _, p := range result.Projects {
+		for _, it := range p.IssueTypes {
+			return it.Fields, nil
+		}
+	}
+	return nil, fmt.Errorf("jira: no createmeta for project %q issue type %q", projectKey, issueType)
+}
+
+// GetEditMeta fetches the field schema Jira expects when editing issueKey.
+func (j *Jira) GetEditMeta(issueKey string) (map[string]*FieldMeta, error) {
+	requestUrl := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/editmeta"
+
+	contents, _, err := j.buildAndExecRequest("GET", requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Fields map[string]*FieldMeta `json:"fields"`
+	}
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return result.Fields, nil
+}
@@ -0,0 +1,111 @@
+package gojira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newMetaTestJira(t *testing.T, path, body string) (*Jira, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("request path = %s, want %s", r.URL.Path, path)
+		}
+		fmt.Fprint(w, body)
+	}))
+
+	jira := &Jira{
+		BaseUrl: server.URL,
+		ApiPath: "",
+		Client:  server.Client(),
+		Auth:    &Auth{Login: "user", Password: "pass"},
+	}
+	return jira, server
+}
+
+func TestGetCreateMetaParsesFields(t *testing.T) {
+	body := `{
+		"projects": [
+			{
+				"key": "PROJ",
+				"issuetypes": [
+					{
+						"name": "Bug",
+						"fields": {
+							"summary": {"required": true, "name": "Summary", "schema": {"type": "string"}},
+							"customfield_10021": {
+								"required": false,
+								"name": "Story Points",
+								"key": "customfield_10021",
+								"schema": {"type": "number", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:float", "customId": 10021}
+							}
+						}
+					}
+				]
+			}
+		]
+	}`
+	jira, server := newMetaTestJira(t, "/issue/createmeta", body)
+	defer server.Close()
+
+	fields, err := jira.GetCreateMeta("PROJ", "Bug")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, ok := fields["summary"]
+	if !ok {
+		t.Fatalf("fields missing %q: %v", "summary", fields)
+	}
+	if !summary.Required || summary.Name != "Summary" || summary.Schema.Type != "string" {
+		t.Errorf("summary = %+v", summary)
+	}
+
+	sp, ok := fields["customfield_10021"]
+	if !ok {
+		t.Fatalf("fields missing %q: %v", "customfield_10021", fields)
+	}
+	if sp.Required || sp.Schema.CustomId != 10021 {
+		t.Errorf("customfield_10021 = %+v", sp)
+	}
+}
+
+func TestGetCreateMetaNotFound(t *testing.T) {
+	jira, server := newMetaTestJira(t, "/issue/createmeta", `{"projects": []}`)
+	defer server.Close()
+
+	_, err := jira.GetCreateMeta("PROJ", "Bug")
+	if err == nil {
+		t.Fatal("expected error for missing createmeta, got nil")
+	}
+	if !strings.Contains(err.Error(), "PROJ") || !strings.Contains(err.Error(), "Bug") {
+		t.Errorf("error = %q, want it to mention project and issue type", err)
+	}
+}
+
+func TestGetEditMetaParsesFields(t *testing.T) {
+	body := `{
+		"fields": {
+			"summary": {"required": true, "name": "Summary", "schema": {"type": "string"}}
+		}
+	}`
+	jira, server := newMetaTestJira(t, "/issue/PROJ-1/editmeta", body)
+	defer server.Close()
+
+	fields, err := jira.GetEditMeta("PROJ-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, ok := fields["summary"]
+	if !ok {
+		t.Fatalf("fields missing %q: %v", "summary", fields)
+	}
+	if !summary.Required || summary.Name != "Summary" {
+		t.Errorf("summary = %+v", summary)
+	}
+}
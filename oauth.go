@@ -0,0 +1,243 @@
+package gojira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OAuthCredentials holds the access token returned by a completed
+// three-legged OAuth 1.0a handshake, along with the consumer's RSA private
+// key. When set on an Auth, it is used to sign every request instead of
+// HTTP Basic auth. There is no AccessSecret: RSA-SHA1 signs with the
+// consumer's private key alone, unlike HMAC-SHA1 where the token secret
+// forms part of the signing key.
+type OAuthCredentials struct {
+	ConsumerKey string
+	PrivateKey  *rsa.PrivateKey
+	AccessToken string
+}
+
+// authHeader builds the "Authorization: OAuth ..." header value for a
+// request to the given method/url, signed RSA-SHA1 per OAuth 1.0a.
+func (o *OAuthCredentials) authHeader(method, rawurl string) (string, error) {
+	params := map[string]string{
+		"oauth_consumer_key":     o.ConsumerKey,
+		"oauth_nonce":            oauthNonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if o.AccessToken != "" {
+		params["oauth_token"] = o.AccessToken
+	}
+
+	signature, err := signRSASHA1(method, rawurl, params, o.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	params["oauth_signature"] = signature
+
+	return "OAuth " + encodeOAuthHeaderParams(params), nil
+}
+
+// OAuthHandshake drives the three-legged OAuth 1.0a dance (request token ->
+// user authorization -> access token) used to bootstrap an AccessToken /
+// AccessSecret pair for a consumer that doesn't have one yet.
+type OAuthHandshake struct {
+	ConsumerKey     string
+	PrivateKey      *rsa.PrivateKey
+	RequestTokenURL string
+	AuthorizeURL    string
+	AccessTokenURL  string
+	CallbackURL     string
+	Client          *http.Client
+}
+
+func NewOAuthHandshake(consumerKey string, privateKey *rsa.PrivateKey, requestTokenURL, authorizeURL, accessTokenURL string) *OAuthHandshake {
+	return &OAuthHandshake{
+		ConsumerKey:     consumerKey,
+		PrivateKey:      privateKey,
+		RequestTokenURL: requestTokenURL,
+		AuthorizeURL:    authorizeURL,
+		AccessTokenURL:  accessTokenURL,
+		CallbackURL:     "oob",
+		Client:          http.DefaultClient,
+	}
+}
+
+// RequestToken performs leg one: obtains a temporary request token/secret
+// that the user must authorize.
+func (h *OAuthHandshake) RequestToken() (token string, secret string, err error) {
+	params := map[string]string{
+		"oauth_callback": h.CallbackURL,
+	}
+	return h.doTokenRequest(h.RequestTokenURL, params)
+}
+
+// AuthorizationURL builds the URL the end user must visit to grant access
+// to the request token obtained from RequestToken.
+func (h *OAuthHandshake) AuthorizationURL(requestToken string) string {
+	return h.AuthorizeURL + "?oauth_token=" + url.QueryEscape(requestToken)
+}
+
+// AccessToken performs leg three: exchanges an authorized request token
+// (plus the verifier the user was shown after authorizing) for a long-lived
+// access token/secret.
+func (h *OAuthHandshake) AccessToken(requestToken, requestSecret, verifier string) (token string, secret string, err error) {
+	params := map[string]string{
+		"oauth_token":    requestToken,
+		"oauth_verifier": verifier,
+	}
+	return h.doTokenRequest(h.AccessTokenURL, params)
+}
+
+func (h *OAuthHandshake) doTokenRequest(endpoint string, extra map[string]string) (string, string, error) {
+	params := map[string]string{
+		"oauth_consumer_key":     h.ConsumerKey,
+		"oauth_nonce":            oauthNonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	for k, v := range extra {
+		params[k] = v
+	}
+
+	signature, err := signRSASHA1("POST", endpoint, params, h.PrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+	params["oauth_signature"] = signature
+
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Authorization", "OAuth "+encodeOAuthHeaderParams(params))
+
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("oauth: %s returned %d: %s", endpoint, resp.StatusCode, body)
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", "", err
+	}
+	return values.Get("oauth_token"), values.Get("oauth_token_secret"), nil
+}
+
+func oauthNonce() string {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		// crypto/rand failing is unrecoverable; fall back to a
+		// timestamp-derived nonce rather than sign with no nonce at all.
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// signRSASHA1 builds the OAuth 1.0a signature base string for method/rawurl
+// plus params, and signs it with privateKey using RSA-SHA1.
+func signRSASHA1(method, rawurl string, params map[string]string, privateKey *rsa.PrivateKey) (string, error) {
+	base, err := oauthSignatureBase(method, rawurl, params)
+	if err != nil {
+		return "", err
+	}
+
+	hashed := sha1.Sum([]byte(base))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA1, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func oauthSignatureBase(method, rawurl string, params map[string]string) (string, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", err
+	}
+
+	all := map[string]string{}
+	for k, v := range u.Query() {
+		all[k] = v[0]
+	}
+	for k, v := range params {
+		all[k] = v
+	}
+
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(all[k]))
+	}
+	normalizedParams := strings.Join(pairs, "&")
+
+	u.RawQuery = ""
+	u.Fragment = ""
+	normalizedURL := u.String()
+
+	base := strings.ToUpper(method) + "&" + oauthEscape(normalizedURL) + "&" + oauthEscape(normalizedParams)
+	return base, nil
+}
+
+func encodeOAuthHeaderParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, oauthEscape(k), oauthEscape(params[k])))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// oauthEscape percent-encodes s per RFC 3986 (the unreserved set is
+// A-Z a-z 0-9 - _ . ~), as required by the OAuth 1.0a signing spec. Unlike
+// url.QueryEscape, which encodes a space as "+", it encodes a space as "%20".
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
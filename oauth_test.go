@@ -0,0 +1,104 @@
+package gojira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestOauthEscape(t *testing.T) {
+	cases := map[string]string{
+		"abc123-_.~": "abc123-_.~",
+		"a b":        "a%20b",
+		"a+b":        "a%2Bb",
+		"100%":       "100%25",
+	}
+	for in, want := range cases {
+		if got := oauthEscape(in); got != want {
+			t.Errorf("oauthEscape(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestOauthSignatureBase(t *testing.T) {
+	base, err := oauthSignatureBase("GET", "https://jira.example.com/rest/api/2/issue/ABC-1?foo=bar", map[string]string{
+		"oauth_consumer_key": "key",
+		"oauth_nonce":        "nonce",
+		"oauth_timestamp":    "12345",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "GET&https%3A%2F%2Fjira.example.com%2Frest%2Fapi%2F2%2Fissue%2FABC-1&" +
+		"foo%3Dbar%26oauth_consumer_key%3Dkey%26oauth_nonce%3Dnonce%26oauth_timestamp%3D12345"
+	if base != want {
+		t.Errorf("oauthSignatureBase = %q, want %q", base, want)
+	}
+}
+
+// TestAuthHeaderVerifiesAgainstPublicKey checks the full signing path end
+// to end: the header authHeader produces must verify against the same
+// private key's public half when the signature base is rebuilt from the
+// header's own params.
+func TestAuthHeaderVerifiesAgainstPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds := &OAuthCredentials{ConsumerKey: "consumer", PrivateKey: key, AccessToken: "token"}
+	header, err := creds.authHeader("POST", "https://jira.example.com/rest/api/2/issue/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := parseOAuthHeaderParams(t, header)
+	if params["oauth_consumer_key"] != "consumer" || params["oauth_token"] != "token" {
+		t.Fatalf("unexpected header params: %v", params)
+	}
+	if params["oauth_signature_method"] != "RSA-SHA1" {
+		t.Fatalf("expected RSA-SHA1, got %s", params["oauth_signature_method"])
+	}
+
+	signed := map[string]string{}
+	for k, v := range params {
+		if k != "oauth_signature" {
+			signed[k] = v
+		}
+	}
+	base, err := oauthSignatureBase("POST", "https://jira.example.com/rest/api/2/issue/", signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := sha1.Sum([]byte(base))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, hashed[:], sig); err != nil {
+		t.Fatalf("signature did not verify: %s", err)
+	}
+}
+
+func parseOAuthHeaderParams(t *testing.T, header string) map[string]string {
+	t.Helper()
+	header = strings.TrimPrefix(header, "OAuth ")
+	params := map[string]string{}
+	re := regexp.MustCompile(`([a-zA-Z_]+)="([^"]*)"`)
+	for _, m := range re.FindAllStringSubmatch(header, -1) {
+		v, err := url.QueryUnescape(m[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		params[m[1]] = v
+	}
+	return params
+}
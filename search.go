@@ -0,0 +1,152 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SearchOptions controls how SearchAll and SearchIter page through JQL
+// search results.
+type SearchOptions struct {
+	Fields     []string
+	Expand     []string
+	MaxResults int // per page; defaults to 50 if unset
+}
+
+func (o *SearchOptions) maxResults() int {
+	if o == nil || o.MaxResults <= 0 {
+		return 50
+	}
+	return o.MaxResults
+}
+
+func (j *Jira) searchPage(jql string, opts *SearchOptions, startAt int) (SearchResult, error) {
+	requestUrl := j.BaseUrl + j.ApiPath + "/search?jql=" + url.QueryEscape(jql) +
+		"&startAt=" + strconv.Itoa(startAt) + "&maxResults=" + strconv.Itoa(opts.maxResults())
+	if opts != nil && len(opts.Fields) > 0 {
+		requestUrl += "&fields=" + url.QueryEscape(strings.Join(opts.Fields, ","))
+	}
+	if opts != nil && len(opts.Expand) > 0 {
+		requestUrl += "&expand=" + url.QueryEscape(strings.Join(opts.Expand, ","))
+	}
+
+	contents, _, err := j.buildAndExecRequest("GET", requestUrl, nil)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	var result SearchResult
+	err = json.Unmarshal(contents, &result)
+	return result, err
+}
+
+// SearchAll streams every issue matching jql, automatically paging through
+// startAt/maxResults until Jira reports no issues left. Both channels are
+// closed once the search is exhausted, the first error is hit, or ctx is
+// done. Callers that stop ranging over the issues channel before it's
+// exhausted MUST cancel ctx, or the producer goroutine will leak blocked
+// on a send.
+func (j *Jira) SearchAll(ctx context.Context, jql string, opts *SearchOptions) (<-chan *Issue, <-chan error) {
+	issues := make(chan *Issue)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(issues)
+		defer close(errs)
+
+		startAt := 0
+		for {
+			page, err := j.searchPage(jql, opts, startAt)
+			if err != nil {
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+				}
+				return
+			}
+			for _, issue := range page.Issues {
+				select {
+				case issues <- issue:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			startAt += len(page.Issues)
+			if len(page.Issues) == 0 || startAt >= page.Total {
+				return
+			}
+		}
+	}()
+
+	return issues, errs
+}
+
+// SearchIterator walks the results of a JQL search one issue at a time,
+// fetching additional pages from Jira as needed. Construct one with
+// Jira.SearchIter.
+type SearchIterator struct {
+	jira *Jira
+	jql  string
+	opts *SearchOptions
+
+	page    []*Issue
+	pageIdx int
+	startAt int
+	total   int
+	fetched bool
+
+	current *Issue
+	err     error
+}
+
+// SearchIter returns an iterator over every issue matching jql.
+func (j *Jira) SearchIter(jql string, opts *SearchOptions) *SearchIterator {
+	return &SearchIterator{jira: j, jql: jql, opts: opts}
+}
+
+// Next advances the iterator, fetching the next page of results if the
+// current one is exhausted. It returns false once the search is exhausted
+// or an error occurs; check Err to distinguish the two.
+func (it *SearchIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.pageIdx >= len(it.page) {
+		if it.fetched && it.startAt >= it.total {
+			return false
+		}
+
+		page, err := it.jira.searchPage(it.jql, it.opts, it.startAt)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.fetched = true
+		it.total = page.Total
+		it.page = page.Issues
+		it.pageIdx = 0
+		it.startAt += len(page.Issues)
+		if len(page.Issues) == 0 {
+			return false
+		}
+	}
+
+	it.current = it.page[it.pageIdx]
+	it.pageIdx++
+	return true
+}
+
+// Issue returns the issue at the iterator's current position.
+func (it *SearchIterator) Issue() *Issue {
+	return it.current
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
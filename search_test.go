@@ -0,0 +1,153 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newPagingTestJira serves total issues keyed "ISSUE-0".."ISSUE-<total-1>",
+// paginated maxResults at a time starting from the startAt query param (the
+// maxResults query param is ignored).
+func newPagingTestJira(t *testing.T, total, maxResults int) (*Jira, *httptest.Server, *int) {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		pageSize := maxResults
+		if startAt+pageSize > total {
+			pageSize = total - startAt
+		}
+		if pageSize < 0 {
+			pageSize = 0
+		}
+
+		issues := make([]*Issue, pageSize)
+		for i := 0; i < pageSize; i++ {
+			issues[i] = &Issue{Key: fmt.Sprintf("ISSUE-%d", startAt+i)}
+		}
+
+		result := SearchResult{StartAt: startAt, MaxResults: maxResults, Total: total, Issues: issues}
+		json.NewEncoder(w).Encode(result)
+	}))
+
+	jira := &Jira{
+		BaseUrl: server.URL,
+		ApiPath: "",
+		Client:  server.Client(),
+		Auth:    &Auth{Login: "user", Password: "pass"},
+	}
+	return jira, server, &requests
+}
+
+func TestSearchIteratorPaginates(t *testing.T) {
+	jira, server, requests := newPagingTestJira(t, 5, 2)
+	defer server.Close()
+
+	it := jira.SearchIter("project = TEST", &SearchOptions{MaxResults: 2})
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Issue().Key)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"ISSUE-0", "ISSUE-1", "ISSUE-2", "ISSUE-3", "ISSUE-4"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("issue %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	// ceil(5/2) == 3 pages; no trailing empty-page request once startAt
+	// reaches total.
+	if *requests != 3 {
+		t.Errorf("requests = %d, want 3", *requests)
+	}
+}
+
+func TestSearchIteratorExactMultipleOfPageSize(t *testing.T) {
+	jira, server, requests := newPagingTestJira(t, 4, 2)
+	defer server.Close()
+
+	it := jira.SearchIter("project = TEST", &SearchOptions{MaxResults: 2})
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 4 {
+		t.Fatalf("got %d issues, want 4", count)
+	}
+	if *requests != 2 {
+		t.Errorf("requests = %d, want 2 (no trailing empty page)", *requests)
+	}
+}
+
+func TestSearchAllStreamsAllIssues(t *testing.T) {
+	jira, server, _ := newPagingTestJira(t, 5, 2)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	issues, errs := jira.SearchAll(ctx, "project = TEST", &SearchOptions{MaxResults: 2})
+
+	var got []string
+	for issue := range issues {
+		got = append(got, issue.Key)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 5 {
+		t.Fatalf("got %d issues, want 5", len(got))
+	}
+}
+
+// TestSearchAllCancelStopsProducer exercises the early-exit path a caller
+// takes when it only wants the first page: cancel ctx and confirm the
+// producer goroutine unblocks and the channel closes, rather than leaking
+// blocked on a send forever.
+func TestSearchAllCancelStopsProducer(t *testing.T) {
+	jira, server, _ := newPagingTestJira(t, 100, 1)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	issues, _ := jira.SearchAll(ctx, "project = TEST", &SearchOptions{MaxResults: 1})
+
+	first, ok := <-issues
+	if !ok || first.Key != "ISSUE-0" {
+		t.Fatalf("first issue = %v, ok=%v", first, ok)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-issues:
+		if ok {
+			// a second issue may or may not have been in flight when
+			// cancel() landed; drain until closed.
+			for range issues {
+			}
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("issues channel did not close after context cancellation")
+	}
+}
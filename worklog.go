@@ -0,0 +1,122 @@
+package gojira
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Worklog is a single time-tracking entry against an issue.
+type Worklog struct {
+	Id               string
+	Author           *User
+	Comment          string
+	Started          time.Time
+	TimeSpent        string
+	TimeSpentSeconds int
+}
+
+// worklogAlias mirrors Worklog's wire shape, with Started as the raw
+// string Jira expects (DateLayout) rather than a time.Time.
+type worklogAlias struct {
+	Id               string `json:"id,omitempty"`
+	Author           *User  `json:"author,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	Started          string `json:"started,omitempty"`
+	TimeSpent        string `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds,omitempty"`
+}
+
+func (w *Worklog) MarshalJSON() ([]byte, error) {
+	a := worklogAlias{
+		Id:               w.Id,
+		Author:           w.Author,
+		Comment:          w.Comment,
+		TimeSpent:        w.TimeSpent,
+		TimeSpentSeconds: w.TimeSpentSeconds,
+	}
+	if !w.Started.IsZero() {
+		a.Started = w.Started.Format(DateLayout)
+	}
+	return json.Marshal(a)
+}
+
+func (w *Worklog) UnmarshalJSON(data []byte) error {
+	var a worklogAlias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	w.Id = a.Id
+	w.Author = a.Author
+	w.Comment = a.Comment
+	w.TimeSpent = a.TimeSpent
+	w.TimeSpentSeconds = a.TimeSpentSeconds
+
+	if a.Started != "" {
+		started, err := time.Parse(DateLayout, a.Started)
+		if err != nil {
+			return err
+		}
+		w.Started = started
+	}
+	return nil
+}
+
+// AddWorklog logs a new worklog entry against issueKey.
+func (j *Jira) AddWorklog(issueKey string, w *Worklog) (*Worklog, error) {
+	encoded, err := json.MarshalIndent(w, "", "   ")
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/worklog"
+	contents, _, err := j.buildAndExecRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Worklog
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWorklogs lists every worklog entry logged against issueKey.
+func (j *Jira) GetWorklogs(issueKey string) ([]*Worklog, error) {
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/worklog"
+	contents, _, err := j.buildAndExecRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Worklogs []*Worklog `json:"worklogs"`
+	}
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, err
+	}
+	return result.Worklogs, nil
+}
+
+// UpdateWorklog overwrites the worklog entry id on issueKey with w.
+func (j *Jira) UpdateWorklog(issueKey, id string, w *Worklog) error {
+	encoded, err := json.MarshalIndent(w, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	body := bytes.NewBuffer(encoded)
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/worklog/" + id
+	_, _, err = j.buildAndExecRequest("PUT", url, body)
+	return err
+}
+
+// DeleteWorklog removes the worklog entry id from issueKey.
+func (j *Jira) DeleteWorklog(issueKey, id string) error {
+	url := j.BaseUrl + j.ApiPath + "/issue/" + issueKey + "/worklog/" + id
+	_, _, err := j.buildAndExecRequest("DELETE", url, nil)
+	return err
+}
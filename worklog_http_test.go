@@ -0,0 +1,106 @@
+package gojira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAddWorklogRequestBody(t *testing.T) {
+	var captured map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/issue/PROJ-1/worklog" {
+			t.Errorf("request = %s %s, want POST /issue/PROJ-1/worklog", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&captured); err != nil {
+			t.Fatalf("request body is not valid JSON: %s", err)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "100"})
+	}))
+	defer server.Close()
+
+	jira := &Jira{BaseUrl: server.URL, ApiPath: "", Client: server.Client(), Auth: &Auth{Login: "user", Password: "pass"}}
+
+	started, err := time.Parse(DateLayout, "2024-03-14T09:30:00.000-0700")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	added, err := jira.AddWorklog("PROJ-1", &Worklog{TimeSpent: "2h", Comment: "did stuff", Started: started})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.Id != "100" {
+		t.Errorf("added.Id = %q, want %q", added.Id, "100")
+	}
+
+	want := map[string]interface{}{
+		"comment":   "did stuff",
+		"timeSpent": "2h",
+		"started":   "2024-03-14T09:30:00.000-0700",
+	}
+	assertJSONEqual(t, captured, want)
+}
+
+func TestGetWorklogsRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/issue/PROJ-1/worklog" {
+			t.Errorf("request = %s %s, want GET /issue/PROJ-1/worklog", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"worklogs": []map[string]interface{}{
+				{"id": "100", "timeSpent": "1h"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	jira := &Jira{BaseUrl: server.URL, ApiPath: "", Client: server.Client(), Auth: &Auth{Login: "user", Password: "pass"}}
+
+	worklogs, err := jira.GetWorklogs("PROJ-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(worklogs) != 1 || worklogs[0].Id != "100" || worklogs[0].TimeSpent != "1h" {
+		t.Errorf("worklogs = %+v", worklogs)
+	}
+}
+
+func TestUpdateWorklogRequestBody(t *testing.T) {
+	var captured map[string]interface{}
+	jira, server := newTestJira(t, &captured, "PUT", "/issue/PROJ-1/worklog/100")
+	defer server.Close()
+
+	started, err := time.Parse(DateLayout, "2024-03-14T09:30:00.000-0700")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := jira.UpdateWorklog("PROJ-1", "100", &Worklog{TimeSpent: "3h", Started: started}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"timeSpent": "3h",
+		"started":   "2024-03-14T09:30:00.000-0700",
+	}
+	assertJSONEqual(t, captured, want)
+}
+
+func TestDeleteWorklogRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/issue/PROJ-1/worklog/100" {
+			t.Errorf("request = %s %s, want DELETE /issue/PROJ-1/worklog/100", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	jira := &Jira{BaseUrl: server.URL, ApiPath: "", Client: server.Client(), Auth: &Auth{Login: "user", Password: "pass"}}
+
+	if err := jira.DeleteWorklog("PROJ-1", "100"); err != nil {
+		t.Fatal(err)
+	}
+}
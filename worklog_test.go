@@ -0,0 +1,39 @@
+package gojira
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWorklogStartedRoundTrip guards the one behavioral nuance the request
+// called out by name: Started must serialize using Jira's DateLayout, not
+// time.Time's default RFC3339.
+func TestWorklogStartedRoundTrip(t *testing.T) {
+	started, err := time.Parse(DateLayout, "2024-03-14T09:30:00.000-0700")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := &Worklog{TimeSpent: "2h", Started: started}
+	encoded, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["started"] != "2024-03-14T09:30:00.000-0700" {
+		t.Errorf(`started = %v, want "2024-03-14T09:30:00.000-0700"`, raw["started"])
+	}
+
+	var decoded Worklog
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Started.Equal(started) {
+		t.Errorf("Started = %v, want %v", decoded.Started, started)
+	}
+}